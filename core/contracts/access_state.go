@@ -0,0 +1,68 @@
+package contracts
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ReasonCode classifies why a wallet was placed in a non-whitelisted tier,
+// so policy can treat causes differently: a sanctions hit shouldn't expire
+// on the same schedule as a rate-limit cooldown or a pending KYC review.
+type ReasonCode uint8
+
+const (
+	ReasonNone ReasonCode = iota
+	ReasonSanctions
+	ReasonRateLimit
+	ReasonKYCPending
+)
+
+// wacStorageSlot offsets of the wider WAC storage schema read by
+// WalletStatus. Slot 4 (status) is the original layout; 5-7 were added
+// alongside it to support expiry and reason tracking.
+const (
+	slotStatus           = 4
+	slotExpiresAt        = 5
+	slotReasonCode       = 6
+	slotLastUpdatedBlock = 7
+)
+
+// WalletState is the full WAC record for an address: its access tier, when
+// that tier expires, why it was set, and when it was last updated. It
+// replaces the raw 0/1/2/3 that WalletStatus used to return.
+type WalletState struct {
+	Status           *big.Int
+	ExpiresAt        *big.Int
+	ReasonCode       ReasonCode
+	LastUpdatedBlock uint64
+}
+
+// expired reports whether ws's tier should be treated as lapsed as of
+// currentBlock, auto-downgrading back to the sending-greylist tier. If
+// params has a governance-configured expiry window for ws.ReasonCode, that
+// window (measured from LastUpdatedBlock) is used instead of the
+// contract-stored ExpiresAt, so operators can retune how long a reason code
+// stays greylisted without redeploying WAC. A nil params, or no window
+// configured for ws.ReasonCode, falls back to the stored ExpiresAt.
+func (ws *WalletState) expired(currentBlock uint64, params *GovernanceParams) bool {
+	if window := params.windowFor(ws.ReasonCode); window != nil && window.Sign() > 0 {
+		expiresAt := new(big.Int).Add(new(big.Int).SetUint64(ws.LastUpdatedBlock), window)
+		return expiresAt.Uint64() < currentBlock
+	}
+	if ws.ExpiresAt == nil || ws.ExpiresAt.Sign() == 0 {
+		return false // no expiry set
+	}
+	return ws.ExpiresAt.Uint64() < currentBlock
+}
+
+// EffectiveStatus returns ws.Status, downgraded to the sending-greylist tier
+// if its expiry has passed as of currentBlock under params (see expired).
+// Callers that don't track a block number (e.g. one-off RPC queries) can
+// pass ws.LastUpdatedBlock to treat the state as always current.
+func (ws *WalletState) EffectiveStatus(currentBlock uint64, params *GovernanceParams) *big.Int {
+	if ws.expired(currentBlock, params) {
+		return common.Big2
+	}
+	return ws.Status
+}
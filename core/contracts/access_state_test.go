@@ -0,0 +1,68 @@
+package contracts
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestWalletStateEffectiveStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		ws           WalletState
+		params       *GovernanceParams
+		currentBlock uint64
+		want         *big.Int
+	}{
+		{
+			name:         "no expiry set, nil params",
+			ws:           WalletState{Status: common.Big2},
+			currentBlock: 100,
+			want:         common.Big2,
+		},
+		{
+			name:         "stored ExpiresAt not yet reached",
+			ws:           WalletState{Status: common.Big3, ExpiresAt: big.NewInt(200)},
+			currentBlock: 100,
+			want:         common.Big3,
+		},
+		{
+			name:         "stored ExpiresAt passed, no governance window",
+			ws:           WalletState{Status: common.Big3, ExpiresAt: big.NewInt(50)},
+			currentBlock: 100,
+			want:         common.Big2,
+		},
+		{
+			name:         "governance window overrides stored ExpiresAt",
+			ws:           WalletState{Status: common.Big3, ReasonCode: ReasonRateLimit, ExpiresAt: big.NewInt(1_000_000), LastUpdatedBlock: 50},
+			params:       &GovernanceParams{ExpiryWindow: map[ReasonCode]*big.Int{ReasonRateLimit: big.NewInt(10)}},
+			currentBlock: 100,
+			want:         common.Big2,
+		},
+		{
+			name:         "governance window not yet elapsed",
+			ws:           WalletState{Status: common.Big3, ReasonCode: ReasonRateLimit, LastUpdatedBlock: 95},
+			params:       &GovernanceParams{ExpiryWindow: map[ReasonCode]*big.Int{ReasonRateLimit: big.NewInt(10)}},
+			currentBlock: 100,
+			want:         common.Big3,
+		},
+		{
+			name:         "zero-valued window for reason falls back to stored ExpiresAt",
+			ws:           WalletState{Status: common.Big3, ReasonCode: ReasonSanctions, ExpiresAt: big.NewInt(50)},
+			params:       &GovernanceParams{ExpiryWindow: map[ReasonCode]*big.Int{ReasonSanctions: big.NewInt(0)}},
+			currentBlock: 100,
+			want:         common.Big2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := tc.ws
+			got := ws.EffectiveStatus(tc.currentBlock, tc.params)
+			if got.Cmp(tc.want) != 0 {
+				t.Errorf("EffectiveStatus() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
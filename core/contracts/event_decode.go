@@ -0,0 +1,35 @@
+package contracts
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DecodeEventLog decodes vLog against eventABI into a single map keyed by
+// argument name, covering both the non-indexed fields (ABI-encoded in
+// vLog.Data) and the indexed ones (each its own entry in vLog.Topics[1:]).
+// Every SidraToken/WAC event marks most or all of its arguments indexed, so
+// callers that only unpack vLog.Data - as abigen's generated Filterer.Parse*
+// methods never do - end up with an empty or near-empty result. This is the
+// one place that combines both halves; contracts/indexer and the sidra_ RPC
+// subscriptions both depend on it instead of duplicating the logic.
+func DecodeEventLog(eventABI abi.Event, vLog types.Log) (map[string]interface{}, error) {
+	args := make(map[string]interface{})
+	if err := eventABI.Inputs.UnpackIntoMap(args, vLog.Data); err != nil {
+		return nil, fmt.Errorf("contracts: unpack %s data: %w", eventABI.Name, err)
+	}
+	if len(vLog.Topics) > 1 {
+		var indexed abi.Arguments
+		for _, arg := range eventABI.Inputs {
+			if arg.Indexed {
+				indexed = append(indexed, arg)
+			}
+		}
+		if err := abi.ParseTopicsIntoMap(args, indexed, vLog.Topics[1:]); err != nil {
+			return nil, fmt.Errorf("contracts: unpack %s topics: %w", eventABI.Name, err)
+		}
+	}
+	return args, nil
+}
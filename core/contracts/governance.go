@@ -0,0 +1,53 @@
+package contracts
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+)
+
+// governanceParamSlots are the storage slots of the WAC parameters that
+// governance can tune without a hard fork: how long each ReasonCode's
+// greylisting lasts before EffectiveStatus auto-downgrades it.
+//
+// ReasonSanctions deliberately has no slot here: IsTransactionAllowed hard
+// blocks a sanctioned, non-whitelisted sender regardless of EffectiveStatus
+// (see the comment there), so a configured window for it would be read but
+// silently ignored by enforcement. Sanctions only clear by a whitelisting
+// transaction, not by any expiry window.
+var governanceParamSlots = map[ReasonCode]int64{
+	ReasonRateLimit:  11,
+	ReasonKYCPending: 12,
+}
+
+// GovernanceParams holds the operator-tunable expiry windows (in blocks) for
+// each ReasonCode, as last read from the WAC contract's storage. Operators
+// change these by sending a system-contract transaction that updates the
+// corresponding slot; there is no separate Go-side config to keep in sync.
+type GovernanceParams struct {
+	ExpiryWindow map[ReasonCode]*big.Int
+}
+
+// ReadGovernanceParams reads the current operator-configured expiry windows
+// from the WAC contract in statedb.
+func ReadGovernanceParams(statedb *state.StateDB) *GovernanceParams {
+	wacState := statedb.GetOrNewStateObject(WacContractAddr)
+	windows := make(map[ReasonCode]*big.Int, len(governanceParamSlots))
+	for reason, slot := range governanceParamSlots {
+		windows[reason] = wacState.GetState(common.BigToHash(big.NewInt(slot))).Big()
+	}
+	return &GovernanceParams{ExpiryWindow: windows}
+}
+
+// windowFor returns the configured expiry window for reason, or nil if
+// params is nil or has no window for it. A zero window is returned as is;
+// WalletState.expired treats it like no window. WalletState.expired uses
+// this to decide whether a governance-tuned window should override the
+// contract-stored ExpiresAt.
+func (p *GovernanceParams) windowFor(reason ReasonCode) *big.Int {
+	if p == nil {
+		return nil
+	}
+	return p.ExpiryWindow[reason]
+}
@@ -49,28 +49,32 @@ func ComputeMappingHash(addr *common.Address, slot *big.Int) common.Hash {
 	return crypto.Keccak256Hash(concatenated)
 }
 
-func WalletStatus(addr *common.Address, statedb *state.StateDB) *big.Int {
-	if IsSystemAddr(addr) {
-		// Return 1 if the address is nil or one of the system wallets.
-		return common.Big1
-	}
+func WalletStatus(addr *common.Address, statedb *state.StateDB) *WalletState {
 	if addr == nil {
-		// Return 0 if the address is nil.
-		return common.Big0
+		// The zero state for a nil address (e.g. a contract-creation tx's
+		// recipient). Must be checked before the WAC storage lookup below,
+		// whose ComputeMappingHash dereferences addr.
+		return &WalletState{Status: common.Big0}
+	}
+	if IsSystemAddr(addr) {
+		// System wallets are always whitelisted and never expire.
+		return &WalletState{Status: common.Big1}
 	}
 	// Get the state of the WalletAccessControl contract.
 	wacState := statedb.GetOrNewStateObject(WacContractAddr)
 
-	// Calculate the keccak256 hash of the key and slot number.
-	keyHash := ComputeMappingHash(addr, big.NewInt(4))
-
-	// Get the value of the key from the state.
-	value := wacState.GetState(keyHash).Big()
-
-	return value
+	return &WalletState{
+		Status:           wacState.GetState(ComputeMappingHash(addr, big.NewInt(slotStatus))).Big(),
+		ExpiresAt:        wacState.GetState(ComputeMappingHash(addr, big.NewInt(slotExpiresAt))).Big(),
+		ReasonCode:       ReasonCode(wacState.GetState(ComputeMappingHash(addr, big.NewInt(slotReasonCode))).Big().Uint64()),
+		LastUpdatedBlock: wacState.GetState(ComputeMappingHash(addr, big.NewInt(slotLastUpdatedBlock))).Big().Uint64(),
+	}
 }
 
 func IsSystemAddr(addr *common.Address) bool {
+	if addr == nil {
+		return false
+	}
 	return SystemWallets[*addr]
 }
 
@@ -86,10 +90,18 @@ func InRecievingGreyList(value *big.Int) bool {
 	return value.Cmp(common.Big3) == 0
 }
 
-func IsTransactionAllowed(tx *types.Transaction, sender *common.Address, statedb *state.StateDB) bool {
+func IsTransactionAllowed(tx *types.Transaction, sender *common.Address, statedb *state.StateDB, currentBlock uint64) bool {
 	// Get the state of the WalletAccessControl contract.
 	recipient := tx.To()
-	senderStatus := WalletStatus(sender, statedb)
+	params := ReadGovernanceParams(statedb)
+	senderState := WalletStatus(sender, statedb)
+	senderStatus := senderState.EffectiveStatus(currentBlock, params)
+
+	// Sanctions never auto-clear by expiry; every other condition below is
+	// evaluated against the (possibly downgraded) effective status instead.
+	if senderState.ReasonCode == ReasonSanctions && !InWhiteList(senderStatus) {
+		return false
+	}
 
 	// If the sender is whitelisted and the receiver is nil, return true.
 	// This is to allow the creation of new contracts.
@@ -98,7 +110,7 @@ func IsTransactionAllowed(tx *types.Transaction, sender *common.Address, statedb
 		return true
 	}
 	// Get the state of the receiver.
-	receiverStatus := WalletStatus(recipient, statedb)
+	receiverStatus := WalletStatus(recipient, statedb).EffectiveStatus(currentBlock, params)
 	if InWhiteList(senderStatus) && InWhiteList(receiverStatus) {
 		// Return true if both sender and receiver are whitelisted.
 		return true
@@ -0,0 +1,148 @@
+package contracts
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func newTestStateDB(t *testing.T) *state.StateDB {
+	t.Helper()
+	db := state.NewDatabase(rawdb.NewMemoryDatabase())
+	statedb, err := state.New(common.Hash{}, db, nil)
+	if err != nil {
+		t.Fatalf("state.New: %v", err)
+	}
+	return statedb
+}
+
+// setWalletState writes ws into the WAC storage schema WalletStatus reads
+// back from, so tests can set up a wallet's access tier without going
+// through a contract call.
+func setWalletState(statedb *state.StateDB, addr common.Address, ws WalletState) {
+	wacState := statedb.GetOrNewStateObject(WacContractAddr)
+	status := ws.Status
+	if status == nil {
+		status = common.Big0
+	}
+	wacState.SetState(ComputeMappingHash(&addr, big.NewInt(slotStatus)), common.BigToHash(status))
+	if ws.ExpiresAt != nil {
+		wacState.SetState(ComputeMappingHash(&addr, big.NewInt(slotExpiresAt)), common.BigToHash(ws.ExpiresAt))
+	}
+	wacState.SetState(ComputeMappingHash(&addr, big.NewInt(slotReasonCode)), common.BigToHash(big.NewInt(int64(ws.ReasonCode))))
+	wacState.SetState(ComputeMappingHash(&addr, big.NewInt(slotLastUpdatedBlock)), common.BigToHash(new(big.Int).SetUint64(ws.LastUpdatedBlock)))
+}
+
+func TestIsTransactionAllowed(t *testing.T) {
+	sender := common.HexToAddress("0x0000000000000000000000000000000000a1a1")
+	receiver := common.HexToAddress("0x0000000000000000000000000000000000b2b2")
+
+	tests := []struct {
+		name      string
+		sender    WalletState
+		receiver  *WalletState // nil means don't set up any state for receiver
+		recipient *common.Address
+		want      bool
+	}{
+		{
+			name:      "whitelisted sender, whitelisted receiver",
+			sender:    WalletState{Status: common.Big1},
+			receiver:  &WalletState{Status: common.Big1},
+			recipient: &receiver,
+			want:      true,
+		},
+		{
+			name:      "whitelisted sender creating a contract (nil recipient)",
+			sender:    WalletState{Status: common.Big1},
+			recipient: nil,
+			want:      true,
+		},
+		{
+			name:      "unrestricted sender, unrestricted receiver (neither greylisted)",
+			sender:    WalletState{Status: common.Big0},
+			receiver:  &WalletState{Status: common.Big0},
+			recipient: &receiver,
+			want:      true,
+		},
+		{
+			name:      "sending-greylisted sender to a non-system receiver",
+			sender:    WalletState{Status: common.Big2},
+			receiver:  &WalletState{Status: common.Big0},
+			recipient: &receiver,
+			want:      false,
+		},
+		{
+			name:      "sending-greylisted sender to a system wallet",
+			sender:    WalletState{Status: common.Big2},
+			recipient: &WacContractAddr,
+			want:      true,
+		},
+		{
+			name:      "sending-greylisted sender creating a contract (nil recipient, must not panic)",
+			sender:    WalletState{Status: common.Big2},
+			recipient: nil,
+			want:      false,
+		},
+		{
+			name:      "receiving-greylisted receiver blocks a non-greylisted sender",
+			sender:    WalletState{Status: common.Big0},
+			receiver:  &WalletState{Status: common.Big3},
+			recipient: &receiver,
+			want:      false,
+		},
+		{
+			name:      "sanctioned sender is blocked even though not greylisted for sending",
+			sender:    WalletState{Status: common.Big0, ReasonCode: ReasonSanctions},
+			receiver:  &WalletState{Status: common.Big0},
+			recipient: &receiver,
+			want:      false,
+		},
+		{
+			name:      "sanctioned sender stays blocked after its stored ExpiresAt has passed",
+			sender:    WalletState{Status: common.Big2, ReasonCode: ReasonSanctions, ExpiresAt: big.NewInt(1)},
+			receiver:  &WalletState{Status: common.Big0},
+			recipient: &receiver,
+			want:      false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			statedb := newTestStateDB(t)
+			setWalletState(statedb, sender, tc.sender)
+			if tc.receiver != nil {
+				setWalletState(statedb, receiver, *tc.receiver)
+			}
+
+			tx := types.NewTransaction(0, common.Address{}, big.NewInt(0), 21000, big.NewInt(1), nil)
+			if tc.recipient != nil {
+				tx = types.NewTransaction(0, *tc.recipient, big.NewInt(0), 21000, big.NewInt(1), nil)
+			} else {
+				tx = types.NewContractCreation(0, big.NewInt(0), 21000, big.NewInt(1), nil)
+			}
+
+			got := IsTransactionAllowed(tx, &sender, statedb, 100)
+			if got != tc.want {
+				t.Errorf("IsTransactionAllowed() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWalletStatusNilAddress(t *testing.T) {
+	statedb := newTestStateDB(t)
+	ws := WalletStatus(nil, statedb)
+	if ws.Status.Cmp(common.Big0) != 0 {
+		t.Errorf("WalletStatus(nil) = %v, want 0", ws.Status)
+	}
+}
+
+func TestIsSystemAddrNil(t *testing.T) {
+	if IsSystemAddr(nil) {
+		t.Error("IsSystemAddr(nil) = true, want false")
+	}
+}
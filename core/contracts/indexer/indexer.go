@@ -0,0 +1,257 @@
+// Package indexer subscribes to SidraToken/WalletAccessControl events and
+// fans them out to pluggable sinks (SQL, JSON-lines, Kafka, ...), so that
+// downstream consumers don't each have to re-implement log decoding,
+// backfill, checkpointing, and reorg handling on top of core/contracts.
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/contracts"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// DefaultBlockBatchSize is the number of blocks fetched per backfill request
+// when Config.BlockBatchSize is left at zero.
+const DefaultBlockBatchSize = 2000
+
+// indexedEvents are the SidraToken log names this package knows how to
+// decode and forward to sinks.
+var indexedEvents = []string{
+	"Transfer", "Mined", "MinerStatus", "Converted",
+	"MintedByOwner", "Paused", "Unpaused", "ActiveMiners",
+	"TokenSupply", "ConvertedSupply",
+}
+
+// Event is a decoded SidraToken/WAC log handed to a Sink.
+type Event struct {
+	Name        string
+	BlockNumber uint64
+	TxHash      common.Hash
+	LogIndex    uint
+	Removed     bool // true when emitted as part of a reorg rewind
+	Args        map[string]interface{}
+}
+
+// Sink receives decoded events in block order. Implementations must be safe
+// to call from a single goroutine; the Indexer never calls a Sink
+// concurrently with itself.
+type Sink interface {
+	WriteEvent(ctx context.Context, event *Event) error
+}
+
+// CheckpointStore persists the last block number the indexer has fully
+// processed, so a restart resumes instead of re-scanning from genesis.
+type CheckpointStore interface {
+	LoadCheckpoint(ctx context.Context) (blockNumber uint64, found bool, err error)
+	SaveCheckpoint(ctx context.Context, blockNumber uint64) error
+}
+
+// ChainBackend is the subset of bind.ContractBackend/ethereum.ChainReader the
+// indexer needs: log filtering for backfill, log subscription for the live
+// tail, and a header lookup. Start uses the header lookup only to resolve
+// the chain head before tailing (reorgs reach the indexer as Removed logs
+// from the subscription), so New takes a ChainBackend rather than the
+// narrower bind.ContractFilterer.
+type ChainBackend interface {
+	bind.ContractFilterer
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Config controls indexer behavior.
+type Config struct {
+	// ContractAddr is the address whose logs are indexed (typically
+	// contracts.SidraTokenAddr).
+	ContractAddr common.Address
+	// BlockBatchSize is the number of blocks requested per backfill
+	// eth_getLogs call. Defaults to DefaultBlockBatchSize.
+	BlockBatchSize uint64
+	// StartBlock is where backfill begins when no checkpoint is found.
+	StartBlock uint64
+}
+
+// Indexer backfills and then tails SidraToken/WAC events, delivering each
+// decoded Event to every configured Sink in block order.
+type Indexer struct {
+	cfg        Config
+	backend    ChainBackend
+	checkpoint CheckpointStore
+	sinks      []Sink
+
+	mu   sync.Mutex
+	quit chan struct{}
+	wg   sync.WaitGroup
+
+	eventCounters map[string]metrics.Meter
+}
+
+// New creates an Indexer reading logs from backend and persisting its
+// position via checkpoint. Events are delivered to sinks in the order
+// given.
+func New(cfg Config, backend ChainBackend, checkpoint CheckpointStore, sinks ...Sink) *Indexer {
+	if cfg.BlockBatchSize == 0 {
+		cfg.BlockBatchSize = DefaultBlockBatchSize
+	}
+	counters := make(map[string]metrics.Meter, len(indexedEvents))
+	for _, name := range indexedEvents {
+		counters[name] = metrics.NewRegisteredMeter(fmt.Sprintf("contracts/indexer/%s", name), nil)
+	}
+	return &Indexer{
+		cfg:           cfg,
+		backend:       backend,
+		checkpoint:    checkpoint,
+		sinks:         sinks,
+		quit:          make(chan struct{}),
+		eventCounters: counters,
+	}
+}
+
+// Backfill scans [from, to] in Config.BlockBatchSize windows, decoding and
+// delivering every indexed event to the configured sinks, and checkpointing
+// after each window so a crash resumes from the last completed window
+// rather than from the start.
+func (idx *Indexer) Backfill(ctx context.Context, from, to uint64) error {
+	wacAbi := contracts.GetSidraTokenAbi()
+	for start := from; start <= to; start += idx.cfg.BlockBatchSize {
+		end := start + idx.cfg.BlockBatchSize - 1
+		if end > to {
+			end = to
+		}
+		query := ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(start),
+			ToBlock:   new(big.Int).SetUint64(end),
+			Addresses: []common.Address{idx.cfg.ContractAddr},
+		}
+		logs, err := idx.backend.FilterLogs(ctx, query)
+		if err != nil {
+			return fmt.Errorf("indexer: backfill %d-%d: %w", start, end, err)
+		}
+		for _, vLog := range logs {
+			if err := idx.decodeAndDeliver(ctx, wacAbi, vLog); err != nil {
+				return err
+			}
+		}
+		if idx.checkpoint != nil {
+			if err := idx.checkpoint.SaveCheckpoint(ctx, end); err != nil {
+				return fmt.Errorf("indexer: checkpoint %d: %w", end, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Start backfills from the last checkpoint (or Config.StartBlock) and then
+// tails new logs until ctx is canceled or Stop is called.
+// Logs marked Removed signal a reorg rewind; sinks must treat them as
+// retractions of a previously delivered event with the same TxHash/LogIndex.
+func (idx *Indexer) Start(ctx context.Context) error {
+	from := idx.cfg.StartBlock
+	if idx.checkpoint != nil {
+		if last, found, err := idx.checkpoint.LoadCheckpoint(ctx); err != nil {
+			return err
+		} else if found {
+			from = last + 1
+		}
+	}
+
+	head, err := idx.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("indexer: resolve chain head: %w", err)
+	}
+	if head.Number.Uint64() >= from {
+		if err := idx.Backfill(ctx, from, head.Number.Uint64()); err != nil {
+			return err
+		}
+	}
+
+	logCh := make(chan types.Log, 256)
+	sub, err := idx.backend.SubscribeFilterLogs(ctx, ethereum.FilterQuery{
+		Addresses: []common.Address{idx.cfg.ContractAddr},
+	}, logCh)
+	if err != nil {
+		return fmt.Errorf("indexer: subscribe logs: %w", err)
+	}
+
+	wacAbi := contracts.GetSidraTokenAbi()
+	idx.wg.Add(1)
+	go func() {
+		defer idx.wg.Done()
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case err := <-sub.Err():
+				log.Error("indexer: log subscription error", "err", err)
+				return
+			case vLog := <-logCh:
+				if err := idx.decodeAndDeliver(ctx, wacAbi, vLog); err != nil {
+					log.Error("indexer: failed to deliver event", "err", err)
+					continue
+				}
+				if idx.checkpoint != nil {
+					if err := idx.checkpoint.SaveCheckpoint(ctx, vLog.BlockNumber); err != nil {
+						log.Error("indexer: failed to checkpoint", "err", err)
+					}
+				}
+			case <-idx.quit:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop terminates the live tail started by Start and waits for it to exit.
+func (idx *Indexer) Stop() {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	select {
+	case <-idx.quit:
+		// already stopped
+	default:
+		close(idx.quit)
+	}
+	idx.wg.Wait()
+}
+
+func (idx *Indexer) decodeAndDeliver(ctx context.Context, wacAbi abi.ABI, vLog types.Log) error {
+	if len(vLog.Topics) == 0 {
+		return nil
+	}
+	eventABI, err := wacAbi.EventByID(vLog.Topics[0])
+	if err != nil {
+		// Not one of ours; skip silently, other contracts may share the address space.
+		return nil
+	}
+	args, err := contracts.DecodeEventLog(*eventABI, vLog)
+	if err != nil {
+		return fmt.Errorf("indexer: %w", err)
+	}
+	event := &Event{
+		Name:        eventABI.Name,
+		BlockNumber: vLog.BlockNumber,
+		TxHash:      vLog.TxHash,
+		LogIndex:    vLog.Index,
+		Removed:     vLog.Removed,
+		Args:        args,
+	}
+	if counter, ok := idx.eventCounters[event.Name]; ok {
+		counter.Mark(1)
+	}
+	for _, sink := range idx.sinks {
+		if err := sink.WriteEvent(ctx, event); err != nil {
+			return fmt.Errorf("indexer: sink write %s: %w", event.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package indexer
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/contracts"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// recordingSink collects every event handed to it, in order.
+type recordingSink struct {
+	events []*Event
+}
+
+func (s *recordingSink) WriteEvent(_ context.Context, event *Event) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestDecodeAndDeliverIndexedArgs(t *testing.T) {
+	wacAbi := contracts.GetSidraTokenAbi()
+	from := common.HexToAddress("0x0000000000000000000000000000000000a1a1")
+	to := common.HexToAddress("0x0000000000000000000000000000000000b2b2")
+	wallet := common.HexToAddress("0x0000000000000000000000000000000000c3c3")
+
+	tests := []struct {
+		name string
+		log  types.Log
+		want map[string]interface{}
+	}{
+		{
+			// Transfer has one non-indexed field (_value) and two indexed
+			// ones (_from, _to); the data payload alone only recovers _value.
+			name: "Transfer",
+			log: types.Log{
+				Topics: []common.Hash{
+					wacAbi.Events["Transfer"].ID,
+					common.BytesToHash(from.Bytes()),
+					common.BytesToHash(to.Bytes()),
+				},
+				Data: common.LeftPadBytes(big.NewInt(42).Bytes(), 32),
+			},
+			want: map[string]interface{}{
+				"_from":  from,
+				"_to":    to,
+				"_value": big.NewInt(42),
+			},
+		},
+		{
+			// MinerStatus is fully indexed, so unpacking Data alone (the old
+			// behavior) recovered nothing at all.
+			name: "MinerStatus",
+			log: types.Log{
+				Topics: []common.Hash{
+					wacAbi.Events["MinerStatus"].ID,
+					common.BytesToHash(wallet.Bytes()),
+					common.BigToHash(big.NewInt(1)),
+					common.BigToHash(big.NewInt(100)),
+				},
+			},
+			want: map[string]interface{}{
+				"_wallet": wallet,
+				"_status": true,
+				"_at":     big.NewInt(100),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sink := &recordingSink{}
+			idx := New(Config{ContractAddr: contracts.SidraTokenAddr}, nil, nil, sink)
+
+			if err := idx.decodeAndDeliver(context.Background(), wacAbi, tc.log); err != nil {
+				t.Fatalf("decodeAndDeliver: %v", err)
+			}
+			if len(sink.events) != 1 {
+				t.Fatalf("expected 1 event, got %d", len(sink.events))
+			}
+			got := sink.events[0].Args
+			for key, want := range tc.want {
+				gotVal, ok := got[key]
+				if !ok {
+					t.Fatalf("missing arg %q in decoded event %v", key, got)
+				}
+				if wantBig, isBig := want.(*big.Int); isBig {
+					if gotBig, ok := gotVal.(*big.Int); !ok || gotBig.Cmp(wantBig) != 0 {
+						t.Errorf("arg %q = %v, want %v", key, gotVal, wantBig)
+					}
+					continue
+				}
+				if wantAddr, isAddr := want.(common.Address); isAddr {
+					if gotAddr, ok := gotVal.(common.Address); !ok || gotAddr != wantAddr {
+						t.Errorf("arg %q = %v, want %v", key, gotVal, wantAddr)
+					}
+					continue
+				}
+				if gotVal != want {
+					t.Errorf("arg %q = %v, want %v", key, gotVal, want)
+				}
+			}
+		})
+	}
+}
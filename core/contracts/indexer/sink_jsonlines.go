@@ -0,0 +1,28 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// JSONLineSink appends each event to w as one JSON object per line.
+type JSONLineSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewJSONLineSink returns a Sink that writes newline-delimited JSON to w.
+func NewJSONLineSink(w io.Writer) *JSONLineSink {
+	return &JSONLineSink{w: w}
+}
+
+// WriteEvent implements Sink.
+func (s *JSONLineSink) WriteEvent(_ context.Context, event *Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	enc := json.NewEncoder(s.w)
+	return enc.Encode(event)
+}
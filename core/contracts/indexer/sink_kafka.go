@@ -0,0 +1,36 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Producer is the minimal surface KafkaSink needs from a Kafka client. It is
+// defined here rather than importing a specific Kafka library so that
+// callers can plug in whichever client (and whichever broker configuration)
+// their deployment already uses.
+type Producer interface {
+	Produce(ctx context.Context, topic string, key, value []byte) error
+}
+
+// KafkaSink publishes each event as a JSON-encoded Kafka message, keyed by
+// the event name so consumers can partition by event type.
+type KafkaSink struct {
+	producer Producer
+	topic    string
+}
+
+// NewKafkaSink returns a Sink that publishes events to topic via producer.
+func NewKafkaSink(producer Producer, topic string) *KafkaSink {
+	return &KafkaSink{producer: producer, topic: topic}
+}
+
+// WriteEvent implements Sink.
+func (s *KafkaSink) WriteEvent(ctx context.Context, event *Event) error {
+	value, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("indexer: marshal event for kafka: %w", err)
+	}
+	return s.producer.Produce(ctx, s.topic, []byte(event.Name), value)
+}
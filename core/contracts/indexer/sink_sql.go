@@ -0,0 +1,51 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+)
+
+// validTableName matches a bare SQL identifier: letters, digits, and
+// underscores, not starting with a digit. NewSQLSink rejects anything else
+// so an operator-supplied table name can't be used to inject arbitrary SQL
+// into the INSERT statement below.
+var validTableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// SQLSink writes each event as a row into a table with columns
+// (name, block_number, tx_hash, log_index, removed, args), where args is
+// the event's decoded arguments serialized as JSON. Callers are expected to
+// have created the table and registered the appropriate database/sql
+// driver beforehand.
+type SQLSink struct {
+	db    *sql.DB
+	table string
+}
+
+// NewSQLSink returns a Sink that inserts events into table via db. It
+// errors if table isn't a bare SQL identifier, since table is interpolated
+// into the INSERT statement text rather than bound as a parameter.
+func NewSQLSink(db *sql.DB, table string) (*SQLSink, error) {
+	if !validTableName.MatchString(table) {
+		return nil, fmt.Errorf("indexer: invalid table name %q", table)
+	}
+	return &SQLSink{db: db, table: table}, nil
+}
+
+// WriteEvent implements Sink.
+func (s *SQLSink) WriteEvent(ctx context.Context, event *Event) error {
+	argsJSON, err := json.Marshal(event.Args)
+	if err != nil {
+		return fmt.Errorf("indexer: marshal args for %s: %w", event.Name, err)
+	}
+	query := fmt.Sprintf(
+		`INSERT INTO %q (name, block_number, tx_hash, log_index, removed, args) VALUES (?, ?, ?, ?, ?, ?)`,
+		s.table,
+	)
+	_, err = s.db.ExecContext(ctx, query,
+		event.Name, event.BlockNumber, event.TxHash.Hex(), event.LogIndex, event.Removed, argsJSON,
+	)
+	return err
+}
@@ -0,0 +1,66 @@
+// Package maker provides ContractMaker, a single place to obtain typed
+// bindings for the SidraToken/Owner/WalletAccessControl system contracts at
+// their well-known addresses.
+package maker
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/contracts"
+	"github.com/ethereum/go-ethereum/core/contracts/owner"
+	"github.com/ethereum/go-ethereum/core/contracts/sidratoken"
+	"github.com/ethereum/go-ethereum/core/contracts/wac"
+	"github.com/ethereum/go-ethereum/core/state"
+)
+
+// ContractMaker builds typed bindings for the well-known system contracts at
+// their fixed addresses, so callers don't have to repeat
+// OwnerContractAddr/WacContractAddr/SidraTokenAddr at every call site. Each
+// method still returns the binding constructor's error to the caller.
+type ContractMaker struct {
+	backend bind.ContractBackend
+}
+
+// NewContractMaker returns a ContractMaker that resolves system contracts
+// through backend, e.g. an ethclient.Client for RPC-based callers.
+func NewContractMaker(backend bind.ContractBackend) *ContractMaker {
+	return &ContractMaker{backend: backend}
+}
+
+// SidraToken returns a typed binding to the SidraToken contract.
+func (m *ContractMaker) SidraToken() (*sidratoken.SidraToken, error) {
+	return sidratoken.NewSidraToken(contracts.SidraTokenAddr, m.backend)
+}
+
+// Owner returns a typed binding to the Owner contract.
+func (m *ContractMaker) Owner() (*owner.Owner, error) {
+	return owner.NewOwner(contracts.OwnerContractAddr, m.backend)
+}
+
+// Wac returns a typed binding to the WalletAccessControl contract.
+func (m *ContractMaker) Wac() (*wac.Wac, error) {
+	return wac.NewWac(contracts.WacContractAddr, m.backend)
+}
+
+// StateContractMaker builds read-only, state.StateDB-backed equivalents of
+// ContractMaker's Owner and Wac bindings for use on the consensus hot path,
+// where an EVM call per lookup would be wasteful. It has no state-backed
+// SidraToken binding.
+type StateContractMaker struct {
+	statedb *state.StateDB
+}
+
+// NewStateContractMaker returns a StateContractMaker reading system
+// contracts directly out of statedb.
+func NewStateContractMaker(statedb *state.StateDB) *StateContractMaker {
+	return &StateContractMaker{statedb: statedb}
+}
+
+// Owner returns a state-backed binding to the Owner contract.
+func (m *StateContractMaker) Owner() *owner.StateBackend {
+	return owner.NewStateBackend(contracts.OwnerContractAddr, m.statedb)
+}
+
+// Wac returns a state-backed binding to the WalletAccessControl contract.
+func (m *StateContractMaker) Wac() *wac.StateBackend {
+	return wac.NewStateBackend(contracts.WacContractAddr, m.statedb)
+}
@@ -0,0 +1,172 @@
+package mining
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/contracts"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+)
+
+// recordingCaller implements SystemCaller, recording every call it's given
+// and optionally failing with err.
+type recordingCaller struct {
+	calls []struct {
+		addr  common.Address
+		input []byte
+	}
+	err error
+}
+
+func (c *recordingCaller) SystemCall(addr common.Address, input []byte) error {
+	c.calls = append(c.calls, struct {
+		addr  common.Address
+		input []byte
+	}{addr, input})
+	return c.err
+}
+
+func newTestStateDB(t *testing.T) *state.StateDB {
+	t.Helper()
+	db := state.NewDatabase(rawdb.NewMemoryDatabase())
+	statedb, err := state.New(common.Hash{}, db, nil)
+	if err != nil {
+		t.Fatalf("state.New: %v", err)
+	}
+	return statedb
+}
+
+func setLastMiningTime(statedb *state.StateDB, miner common.Address, blockTime uint64) {
+	tokenState := statedb.GetOrNewStateObject(contracts.SidraTokenAddr)
+	key := contracts.ComputeMappingHash(&miner, big.NewInt(lastMiningTimeSlot))
+	tokenState.SetState(key, common.BigToHash(new(big.Int).SetUint64(blockTime)))
+}
+
+func TestAccountantFinalize(t *testing.T) {
+	proposer := common.HexToAddress("0x0000000000000000000000000000000000d4d4")
+	mineMethodID := contracts.GetSidraTokenAbi().Methods["mine"].ID
+
+	t.Run("not in cooldown calls mine()", func(t *testing.T) {
+		statedb := newTestStateDB(t)
+		caller := &recordingCaller{}
+		a := NewAccountant(big.NewInt(10))
+
+		if err := a.Finalize(caller, statedb, 1000, proposer); err != nil {
+			t.Fatalf("Finalize: %v", err)
+		}
+		if len(caller.calls) != 1 {
+			t.Fatalf("expected 1 system call, got %d", len(caller.calls))
+		}
+		if caller.calls[0].addr != contracts.SidraTokenAddr {
+			t.Errorf("called %v, want %v", caller.calls[0].addr, contracts.SidraTokenAddr)
+		}
+		if len(caller.calls[0].input) < 4 || !bytesEqual(caller.calls[0].input[:4], mineMethodID) {
+			t.Errorf("input = %x, want mine() selector %x", caller.calls[0].input, mineMethodID)
+		}
+	})
+
+	t.Run("still in cooldown rejects without a system call", func(t *testing.T) {
+		statedb := newTestStateDB(t)
+		setLastMiningTime(statedb, proposer, 995)
+		caller := &recordingCaller{}
+		a := NewAccountant(big.NewInt(10))
+
+		err := a.Finalize(caller, statedb, 1000, proposer)
+		if err == nil {
+			t.Fatal("expected a cooldown error")
+		}
+		if len(caller.calls) != 0 {
+			t.Errorf("expected no system call during cooldown, got %d", len(caller.calls))
+		}
+	})
+
+	t.Run("cooldown elapsed calls mine()", func(t *testing.T) {
+		statedb := newTestStateDB(t)
+		setLastMiningTime(statedb, proposer, 985)
+		caller := &recordingCaller{}
+		a := NewAccountant(big.NewInt(10))
+
+		if err := a.Finalize(caller, statedb, 1000, proposer); err != nil {
+			t.Fatalf("Finalize: %v", err)
+		}
+		if len(caller.calls) != 1 {
+			t.Errorf("expected 1 system call, got %d", len(caller.calls))
+		}
+	})
+
+	t.Run("system address proposer bypasses the cooldown check", func(t *testing.T) {
+		statedb := newTestStateDB(t)
+		setLastMiningTime(statedb, contracts.OwnerContractAddr, 999)
+		caller := &recordingCaller{}
+		a := NewAccountant(big.NewInt(10))
+
+		if err := a.Finalize(caller, statedb, 1000, contracts.OwnerContractAddr); err != nil {
+			t.Fatalf("Finalize: %v", err)
+		}
+		if len(caller.calls) != 1 {
+			t.Errorf("expected 1 system call, got %d", len(caller.calls))
+		}
+	})
+
+	t.Run("system call failure is propagated", func(t *testing.T) {
+		statedb := newTestStateDB(t)
+		caller := &recordingCaller{err: errors.New("boom")}
+		a := NewAccountant(big.NewInt(10))
+
+		if err := a.Finalize(caller, statedb, 1000, proposer); err == nil {
+			t.Fatal("expected the system call error to propagate")
+		}
+	})
+}
+
+func TestReconcile(t *testing.T) {
+	addMethodID := contracts.GetSidraTokenAbi().Methods["batchAddMiner"].ID
+	removeMethodID := contracts.GetSidraTokenAbi().Methods["batchRemoveMiner"].ID
+
+	t.Run("no drift issues no calls", func(t *testing.T) {
+		caller := &recordingCaller{}
+		if err := Reconcile(caller, addrs(1, 2), addrs(1, 2)); err != nil {
+			t.Fatalf("Reconcile: %v", err)
+		}
+		if len(caller.calls) != 0 {
+			t.Errorf("expected no calls, got %d", len(caller.calls))
+		}
+	})
+
+	t.Run("additions and removals each issue one batch call", func(t *testing.T) {
+		caller := &recordingCaller{}
+		if err := Reconcile(caller, addrs(1, 3), addrs(1, 2)); err != nil {
+			t.Fatalf("Reconcile: %v", err)
+		}
+		if len(caller.calls) != 2 {
+			t.Fatalf("expected 2 calls, got %d", len(caller.calls))
+		}
+		var sawAdd, sawRemove bool
+		for _, call := range caller.calls {
+			switch {
+			case len(call.input) >= 4 && bytesEqual(call.input[:4], addMethodID):
+				sawAdd = true
+			case len(call.input) >= 4 && bytesEqual(call.input[:4], removeMethodID):
+				sawRemove = true
+			}
+		}
+		if !sawAdd || !sawRemove {
+			t.Errorf("expected both a batchAddMiner and batchRemoveMiner call, got sawAdd=%v sawRemove=%v", sawAdd, sawRemove)
+		}
+	})
+}
+
+func bytesEqual(a, b []byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
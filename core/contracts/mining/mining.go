@@ -0,0 +1,147 @@
+// Package mining connects the SidraToken mining lifecycle (mine(),
+// lastMiningTime, totalMiners, ActiveMiners, Mined) to consensus block
+// finalization: crediting the block proposer through an internal system
+// call instead of requiring a separate end-user transaction, while
+// enforcing the contract's own lastMiningTime cooldown in Go first so a
+// system call isn't spent on every block.
+package mining
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/contracts"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// lastMiningTimeSlot is the storage slot of SidraToken.lastMiningTime, a
+// mapping(address => uint256). As with core/contracts.ComputeMappingHash,
+// this assumes the same storage layout that the existing WAC slot reads
+// already rely on; adjust it if the deployed contract differs.
+const lastMiningTimeSlot = 2
+
+var (
+	rewardsMeter       = metrics.NewRegisteredMeter("contracts/mining/rewards", nil)
+	cooldownRejections = metrics.NewRegisteredMeter("contracts/mining/cooldownrejections", nil)
+	activeMinersGauge  = metrics.NewRegisteredGauge("contracts/mining/activeminers", nil)
+)
+
+// SystemCaller executes an already ABI-encoded call against a system
+// contract as part of block finalization. It is supplied by the consensus
+// engine, which knows how to run such a call without it being a normal,
+// separately gas-paying user transaction.
+type SystemCaller interface {
+	SystemCall(contractAddr common.Address, input []byte) error
+}
+
+// Accountant credits the block proposer on finalization by calling
+// SidraToken's mine() function through a SystemCaller.
+type Accountant struct {
+	cooldown *big.Int // seconds; checked in Go before spending a system call
+}
+
+// NewAccountant returns an Accountant that enforces cooldown (in seconds)
+// before re-checking a proposer against lastMiningTime.
+func NewAccountant(cooldown *big.Int) *Accountant {
+	return &Accountant{cooldown: cooldown}
+}
+
+// Finalize is called from the consensus engine's block Finalize step. It
+// enforces the lastMiningTime cooldown, then invokes mine() on SidraToken
+// via caller so the proposer is credited in the same block it produced.
+//
+// Call site (not part of this package, since it lives in the consensus
+// engine, e.g. consensus/clique/clique.go's Finalize):
+//
+//	func (c *Clique) Finalize(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, uncles []*types.Header, withdrawals []*types.Withdrawal) {
+//		// ... existing state modifications ...
+//		if c.miningAccountant != nil {
+//			if err := c.miningAccountant.Finalize(c.systemCaller(state, header), state, header.Time, header.Coinbase); err != nil {
+//				log.Warn("mining: Finalize accounting failed", "block", header.Number, "err", err)
+//			}
+//		}
+//	}
+func (a *Accountant) Finalize(caller SystemCaller, statedb *state.StateDB, blockTime uint64, proposer common.Address) error {
+	if !contracts.IsSystemAddr(&proposer) {
+		last := lastMiningTime(statedb, proposer)
+		if last.Sign() > 0 && new(big.Int).Add(last, a.cooldown).Uint64() > blockTime {
+			cooldownRejections.Mark(1)
+			return fmt.Errorf("mining: %s is still in cooldown until block time %d", proposer, new(big.Int).Add(last, a.cooldown).Uint64())
+		}
+	}
+
+	abiInput, err := contracts.GetSidraTokenAbi().Pack("mine")
+	if err != nil {
+		return fmt.Errorf("mining: pack mine(): %w", err)
+	}
+	if err := caller.SystemCall(contracts.SidraTokenAddr, abiInput); err != nil {
+		return fmt.Errorf("mining: mine() system call for %s: %w", proposer, err)
+	}
+	rewardsMeter.Mark(1)
+	return nil
+}
+
+// lastMiningTime reads SidraToken.lastMiningTime[miner] directly from state,
+// avoiding a system call on the (common) path where the proposer is still
+// in cooldown.
+func lastMiningTime(statedb *state.StateDB, miner common.Address) *big.Int {
+	tokenState := statedb.GetOrNewStateObject(contracts.SidraTokenAddr)
+	keyHash := contracts.ComputeMappingHash(&miner, big.NewInt(lastMiningTimeSlot))
+	return tokenState.GetState(keyHash).Big()
+}
+
+// Reconcile compares the miner set the node expects (e.g. derived from WAC
+// whitelist state) against the miner set SidraToken currently has on chain,
+// and issues batchAddMiner/batchRemoveMiner system calls to correct any
+// drift. This is meant to run once at startup, before the node starts
+// relying on per-block Finalize calls.
+func Reconcile(caller SystemCaller, wantMiners, haveMiners []common.Address) error {
+	toAdd, toRemove := diffMinerSets(wantMiners, haveMiners)
+	tokenAbi := contracts.GetSidraTokenAbi()
+
+	if len(toAdd) > 0 {
+		data, err := tokenAbi.Pack("batchAddMiner", toAdd)
+		if err != nil {
+			return fmt.Errorf("mining: pack batchAddMiner: %w", err)
+		}
+		if err := caller.SystemCall(contracts.SidraTokenAddr, data); err != nil {
+			return fmt.Errorf("mining: batchAddMiner system call: %w", err)
+		}
+	}
+	if len(toRemove) > 0 {
+		data, err := tokenAbi.Pack("batchRemoveMiner", toRemove)
+		if err != nil {
+			return fmt.Errorf("mining: pack batchRemoveMiner: %w", err)
+		}
+		if err := caller.SystemCall(contracts.SidraTokenAddr, data); err != nil {
+			return fmt.Errorf("mining: batchRemoveMiner system call: %w", err)
+		}
+	}
+	activeMinersGauge.Update(int64(len(wantMiners)))
+	return nil
+}
+
+// diffMinerSets returns the addresses present in want but missing from have
+// (toAdd), and the addresses present in have but missing from want
+// (toRemove).
+func diffMinerSets(want, have []common.Address) (toAdd, toRemove []common.Address) {
+	haveSet := make(map[common.Address]bool, len(have))
+	for _, addr := range have {
+		haveSet[addr] = true
+	}
+	wantSet := make(map[common.Address]bool, len(want))
+	for _, addr := range want {
+		wantSet[addr] = true
+		if !haveSet[addr] {
+			toAdd = append(toAdd, addr)
+		}
+	}
+	for _, addr := range have {
+		if !wantSet[addr] {
+			toRemove = append(toRemove, addr)
+		}
+	}
+	return toAdd, toRemove
+}
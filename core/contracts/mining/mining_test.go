@@ -0,0 +1,86 @@
+package mining
+
+import (
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func addrs(n ...int64) []common.Address {
+	out := make([]common.Address, len(n))
+	for i, v := range n {
+		out[i] = common.BigToAddress(big.NewInt(v))
+	}
+	return out
+}
+
+func sortedHex(addrs []common.Address) []string {
+	out := make([]string, len(addrs))
+	for i, a := range addrs {
+		out[i] = a.Hex()
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestDiffMinerSets(t *testing.T) {
+	tests := []struct {
+		name            string
+		want, have      []common.Address
+		toAdd, toRemove []common.Address
+	}{
+		{
+			name: "no drift",
+			want: addrs(1, 2, 3),
+			have: addrs(1, 2, 3),
+		},
+		{
+			name:  "additions only",
+			want:  addrs(1, 2, 3),
+			have:  addrs(1),
+			toAdd: addrs(2, 3),
+		},
+		{
+			name:     "removals only",
+			want:     addrs(1),
+			have:     addrs(1, 2, 3),
+			toRemove: addrs(2, 3),
+		},
+		{
+			name:     "both additions and removals",
+			want:     addrs(1, 4),
+			have:     addrs(1, 2),
+			toAdd:    addrs(4),
+			toRemove: addrs(2),
+		},
+		{
+			name: "empty sets",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gotAdd, gotRemove := diffMinerSets(tc.want, tc.have)
+			if got, want := sortedHex(gotAdd), sortedHex(tc.toAdd); !equalSlices(got, want) {
+				t.Errorf("toAdd = %v, want %v", got, want)
+			}
+			if got, want := sortedHex(gotRemove), sortedHex(tc.toRemove); !equalSlices(got, want) {
+				t.Errorf("toRemove = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func equalSlices(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
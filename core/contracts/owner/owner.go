@@ -0,0 +1,85 @@
+// Package owner contains the type-safe Go binding for the Owner system
+// contract referenced by SidraToken.owner().
+//
+// As with core/contracts/wac, the Solidity source was not checked in
+// alongside core/contracts, so ownerAbiString only covers the surface that
+// core/contracts.GetCurrentOwnerAddr already depends on (the owner address
+// at storage slot 0).
+package owner
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+)
+
+const ownerAbiString = `
+[
+  {
+    "inputs": [],
+    "name": "owner",
+    "outputs": [ { "internalType": "address", "name": "", "type": "address" } ],
+    "stateMutability": "view",
+    "type": "function"
+  }
+]
+`
+
+// OwnerMetaData contains the compiled ABI of the Owner contract.
+var OwnerMetaData = &bind.MetaData{
+	ABI: ownerAbiString,
+}
+
+// Owner is an auto generated Go binding around the Owner contract.
+type Owner struct {
+	OwnerCaller // Read-only binding to the contract
+}
+
+// OwnerCaller is an auto generated read-only Go binding around an Ethereum contract.
+type OwnerCaller struct {
+	contract *bind.BoundContract
+}
+
+// NewOwner creates a new instance of Owner, bound to a specific deployed contract.
+func NewOwner(address common.Address, backend bind.ContractBackend) (*Owner, error) {
+	parsed, err := abi.JSON(strings.NewReader(OwnerMetaData.ABI))
+	if err != nil {
+		return nil, err
+	}
+	contractBound := bind.NewBoundContract(address, parsed, backend, backend, backend)
+	return &Owner{OwnerCaller: OwnerCaller{contract: contractBound}}, nil
+}
+
+// Owner returns the current owner address, as read via an ABI-encoded eth_call.
+func (c *OwnerCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "owner")
+	if err != nil {
+		return common.Address{}, err
+	}
+	return *abi.ConvertType(out[0], new(common.Address)).(*common.Address), nil
+}
+
+// StateBackend is an in-process, read-only stand-in for Owner that resolves
+// the owner address directly from a state.StateDB, mirroring
+// contracts.GetCurrentOwnerAddr.
+type StateBackend struct {
+	contractAddr common.Address
+	statedb      *state.StateDB
+}
+
+// NewStateBackend returns a StateBackend reading the Owner contract deployed
+// at contractAddr from statedb.
+func NewStateBackend(contractAddr common.Address, statedb *state.StateDB) *StateBackend {
+	return &StateBackend{contractAddr: contractAddr, statedb: statedb}
+}
+
+// Owner returns the current owner address.
+func (b *StateBackend) Owner() common.Address {
+	ownerState := b.statedb.GetOrNewStateObject(b.contractAddr)
+	value := ownerState.GetState(common.Hash{}).Big()
+	return common.BigToAddress(value)
+}
@@ -0,0 +1,84 @@
+package contracts
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// ErrWalletNotPermitted is returned when a transaction is rejected because
+// its sender or recipient is not permitted to transact under the current
+// WalletAccessControl state.
+var ErrWalletNotPermitted = errors.New("contracts: wallet not permitted to transact")
+
+var (
+	rejectedByWACMeter = metrics.NewRegisteredMeter("contracts/wac/rejected", nil)
+	greylistHitsMeter  = metrics.NewRegisteredMeter("contracts/wac/greylisthits", nil)
+)
+
+// AccessPolicy is the enforcement point for WalletAccessControl, with an
+// admission-time check (PreValidateTx) and an execution-time check
+// (PostStateTransition).
+//
+// Nothing in this tree calls PreValidateTx or PostStateTransition:
+// core/tx_pool.go and core/state_transition.go aren't part of this source
+// checkout (only core/contracts and internal/ethapi are), so neither the tx
+// pool nor the state transition consults an AccessPolicy. No chain-config
+// field sets the enabled flag NewAccessPolicy takes; the caller passes it.
+type AccessPolicy interface {
+	// PreValidateTx is the admission-time check for a transaction about to
+	// enter the mempool: it returns ErrWalletNotPermitted when WAC does not
+	// permit the sender/recipient pair.
+	// currentBlock is used to resolve expiring access tiers.
+	PreValidateTx(tx *types.Transaction, sender common.Address, statedb *state.StateDB, currentBlock uint64) error
+
+	// PostStateTransition is the execution-time check for a transaction that
+	// has executed, re-checking the sender/recipient pair against WAC. This catches the
+	// case where an earlier transaction in the same block changed the
+	// sender's or recipient's access status via governance.
+	PostStateTransition(tx *types.Transaction, sender common.Address, statedb *state.StateDB, currentBlock uint64) error
+}
+
+// wacAccessPolicy is the default AccessPolicy, backed by IsTransactionAllowed.
+type wacAccessPolicy struct {
+	enabled bool
+}
+
+// NewAccessPolicy returns the default WalletAccessControl enforcement policy.
+// Enforcement is gated behind enabled: with enabled false, PreValidateTx and
+// PostStateTransition return nil without reading any state.
+func NewAccessPolicy(enabled bool) AccessPolicy {
+	return &wacAccessPolicy{enabled: enabled}
+}
+
+func (p *wacAccessPolicy) PreValidateTx(tx *types.Transaction, sender common.Address, statedb *state.StateDB, currentBlock uint64) error {
+	if !p.enabled {
+		return nil
+	}
+	return p.check(tx, sender, statedb, currentBlock)
+}
+
+func (p *wacAccessPolicy) PostStateTransition(tx *types.Transaction, sender common.Address, statedb *state.StateDB, currentBlock uint64) error {
+	if !p.enabled {
+		return nil
+	}
+	return p.check(tx, sender, statedb, currentBlock)
+}
+
+func (p *wacAccessPolicy) check(tx *types.Transaction, sender common.Address, statedb *state.StateDB, currentBlock uint64) error {
+	params := ReadGovernanceParams(statedb)
+	senderStatus := WalletStatus(&sender, statedb).EffectiveStatus(currentBlock, params)
+	receiverStatus := WalletStatus(tx.To(), statedb).EffectiveStatus(currentBlock, params)
+	if InSendingGreyList(senderStatus) || InRecievingGreyList(receiverStatus) {
+		greylistHitsMeter.Mark(1)
+	}
+	if IsTransactionAllowed(tx, &sender, statedb, currentBlock) {
+		return nil
+	}
+	rejectedByWACMeter.Mark(1)
+	return fmt.Errorf("%w: sender %s", ErrWalletNotPermitted, sender)
+}
@@ -0,0 +1,84 @@
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestWacAccessPolicyCheck(t *testing.T) {
+	sender := common.HexToAddress("0x0000000000000000000000000000000000a1a1")
+	receiver := common.HexToAddress("0x0000000000000000000000000000000000b2b2")
+
+	tests := []struct {
+		name      string
+		sender    WalletState
+		receiver  WalletState
+		recipient *common.Address
+		wantErr   bool
+	}{
+		{
+			name:      "whitelisted pair is allowed",
+			sender:    WalletState{Status: common.Big1},
+			receiver:  WalletState{Status: common.Big1},
+			recipient: &receiver,
+		},
+		{
+			name:      "sending-greylisted sender to a non-system receiver is rejected",
+			sender:    WalletState{Status: common.Big2},
+			receiver:  WalletState{Status: common.Big0},
+			recipient: &receiver,
+			wantErr:   true,
+		},
+		{
+			name:      "greylisted sender creating a contract does not panic and is rejected",
+			sender:    WalletState{Status: common.Big2},
+			recipient: nil,
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			statedb := newTestStateDB(t)
+			setWalletState(statedb, sender, tc.sender)
+			if tc.recipient != nil {
+				setWalletState(statedb, receiver, tc.receiver)
+			}
+
+			var tx *types.Transaction
+			if tc.recipient != nil {
+				tx = types.NewTransaction(0, *tc.recipient, big.NewInt(0), 21000, big.NewInt(1), nil)
+			} else {
+				tx = types.NewContractCreation(0, big.NewInt(0), 21000, big.NewInt(1), nil)
+			}
+
+			policy := &wacAccessPolicy{enabled: true}
+			err := policy.check(tx, sender, statedb, 100)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("check() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrWalletNotPermitted) {
+				t.Errorf("check() error = %v, want wrapping ErrWalletNotPermitted", err)
+			}
+		})
+	}
+}
+
+func TestWacAccessPolicyDisabled(t *testing.T) {
+	sender := common.HexToAddress("0x0000000000000000000000000000000000a1a1")
+	statedb := newTestStateDB(t)
+	setWalletState(statedb, sender, WalletState{Status: common.Big2})
+
+	tx := types.NewContractCreation(0, big.NewInt(0), 21000, big.NewInt(1), nil)
+	policy := &wacAccessPolicy{enabled: false}
+	if err := policy.PreValidateTx(tx, sender, statedb, 100); err != nil {
+		t.Errorf("PreValidateTx() with enabled=false = %v, want nil", err)
+	}
+	if err := policy.PostStateTransition(tx, sender, statedb, 100); err != nil {
+		t.Errorf("PostStateTransition() with enabled=false = %v, want nil", err)
+	}
+}
@@ -0,0 +1,233 @@
+// Package sidratoken contains a type-safe Go binding for the SidraToken
+// system contract, written in abigen's layout against the ABI embedded in
+// core/contracts. It covers only the methods below and parses only the
+// Transfer and MinerStatus events; the other ABI entries (name, symbol,
+// decimals, balances and the remaining events) have no Go method here. See
+// core/contracts/wac and core/contracts/owner for the companion bindings of
+// the contracts SidraToken references.
+package sidratoken
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/contracts"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// SidraTokenMetaData contains the compiled ABI of the SidraToken contract,
+// reusing the string already maintained in core/contracts so the two never
+// drift apart.
+var SidraTokenMetaData = &bind.MetaData{
+	ABI: contracts.SidraTokenAbiString,
+}
+
+// SidraToken is an auto generated Go binding around an Ethereum contract.
+type SidraToken struct {
+	SidraTokenCaller     // Read-only binding to the contract
+	SidraTokenTransactor // Write-only binding to the contract
+	SidraTokenFilterer   // Log filterer for contract events
+}
+
+// SidraTokenCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SidraTokenCaller struct {
+	contract *bind.BoundContract
+}
+
+// SidraTokenTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SidraTokenTransactor struct {
+	contract *bind.BoundContract
+}
+
+// SidraTokenFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SidraTokenFilterer struct {
+	contract *bind.BoundContract
+}
+
+// NewSidraToken creates a new instance of SidraToken, bound to a specific deployed contract.
+func NewSidraToken(address common.Address, backend bind.ContractBackend) (*SidraToken, error) {
+	parsed, err := abi.JSON(strings.NewReader(SidraTokenMetaData.ABI))
+	if err != nil {
+		return nil, err
+	}
+	contractBound := bind.NewBoundContract(address, parsed, backend, backend, backend)
+	return &SidraToken{
+		SidraTokenCaller:     SidraTokenCaller{contract: contractBound},
+		SidraTokenTransactor: SidraTokenTransactor{contract: contractBound},
+		SidraTokenFilterer:   SidraTokenFilterer{contract: contractBound},
+	}, nil
+}
+
+// BalanceOf returns the SidraToken balance of the given owner.
+func (c *SidraTokenCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "balanceOf", owner)
+	if err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+// TotalSupply returns the current total supply of SidraToken.
+func (c *SidraTokenCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "totalSupply")
+	if err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+// ConvertedSupply returns the amount of SidraToken converted so far.
+func (c *SidraTokenCaller) ConvertedSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "convertedSupply")
+	if err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+// TotalMiners returns the number of registered miners.
+func (c *SidraTokenCaller) TotalMiners(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "totalMiners")
+	if err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+// LastMiningTime returns the unix timestamp a miner last called mine().
+func (c *SidraTokenCaller) LastMiningTime(opts *bind.CallOpts, miner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "lastMiningTime", miner)
+	if err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+// Miner reports whether addr is a registered miner.
+func (c *SidraTokenCaller) Miner(opts *bind.CallOpts, addr common.Address) (bool, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "miner", addr)
+	if err != nil {
+		return false, err
+	}
+	return *abi.ConvertType(out[0], new(bool)).(*bool), nil
+}
+
+// Paused reports whether the contract is currently paused.
+func (c *SidraTokenCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "paused")
+	if err != nil {
+		return false, err
+	}
+	return *abi.ConvertType(out[0], new(bool)).(*bool), nil
+}
+
+// Wac returns the address of the WalletAccessControl contract this token defers to.
+func (c *SidraTokenCaller) Wac(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "wac")
+	if err != nil {
+		return common.Address{}, err
+	}
+	return *abi.ConvertType(out[0], new(common.Address)).(*common.Address), nil
+}
+
+// Owner returns the address of the Owner contract that governs this token.
+func (c *SidraTokenCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "owner")
+	if err != nil {
+		return common.Address{}, err
+	}
+	return *abi.ConvertType(out[0], new(common.Address)).(*common.Address), nil
+}
+
+// Mine submits a mine() transaction for the caller.
+func (t *SidraTokenTransactor) Mine(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return t.contract.Transact(opts, "mine")
+}
+
+// AddMiner registers addr as a miner.
+func (t *SidraTokenTransactor) AddMiner(opts *bind.TransactOpts, addr common.Address) (*types.Transaction, error) {
+	return t.contract.Transact(opts, "addMiner", addr)
+}
+
+// RemoveMiner deregisters addr as a miner.
+func (t *SidraTokenTransactor) RemoveMiner(opts *bind.TransactOpts, addr common.Address) (*types.Transaction, error) {
+	return t.contract.Transact(opts, "removeMiner", addr)
+}
+
+// BatchAddMiner registers a batch of addresses as miners in a single call.
+func (t *SidraTokenTransactor) BatchAddMiner(opts *bind.TransactOpts, addrs []common.Address) (*types.Transaction, error) {
+	return t.contract.Transact(opts, "batchAddMiner", addrs)
+}
+
+// BatchRemoveMiner deregisters a batch of addresses as miners in a single call.
+func (t *SidraTokenTransactor) BatchRemoveMiner(opts *bind.TransactOpts, addrs []common.Address) (*types.Transaction, error) {
+	return t.contract.Transact(opts, "batchRemoveMiner", addrs)
+}
+
+// Convert converts amount of the legacy balance into SidraToken.
+func (t *SidraTokenTransactor) Convert(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	return t.contract.Transact(opts, "convert", amount)
+}
+
+// Mint mints amount of SidraToken to the given address.
+func (t *SidraTokenTransactor) Mint(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return t.contract.Transact(opts, "mint", to, amount)
+}
+
+// Pause pauses all token transfers.
+func (t *SidraTokenTransactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return t.contract.Transact(opts, "pause")
+}
+
+// Unpause resumes token transfers.
+func (t *SidraTokenTransactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return t.contract.Transact(opts, "unpause")
+}
+
+// SidraTokenTransfer mirrors the Transfer event emitted by the contract.
+type SidraTokenTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log
+}
+
+// SidraTokenMinerStatus mirrors the MinerStatus event emitted by the contract.
+type SidraTokenMinerStatus struct {
+	Wallet common.Address
+	Status bool
+	At     *big.Int
+	Raw    types.Log
+}
+
+// ParseTransfer unpacks a raw log into a SidraTokenTransfer event.
+func (f *SidraTokenFilterer) ParseTransfer(log types.Log) (*SidraTokenTransfer, error) {
+	event := new(SidraTokenTransfer)
+	if err := f.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ParseMinerStatus unpacks a raw log into a SidraTokenMinerStatus event.
+func (f *SidraTokenFilterer) ParseMinerStatus(log types.Log) (*SidraTokenMinerStatus, error) {
+	event := new(SidraTokenMinerStatus)
+	if err := f.contract.UnpackLog(event, "MinerStatus", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,99 @@
+// Package wac contains the type-safe Go binding for the
+// WalletAccessControl system contract.
+//
+// The contract's Solidity source was never checked in alongside
+// core/contracts, so wacAbiString below only reconstructs the status
+// mapping at storage slot 4. core/contracts.WalletStatus also reads the
+// expiry, reason-code and last-updated slots (5-7) directly from storage;
+// those have no ABI entry or Go method here.
+package wac
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/contracts"
+	"github.com/ethereum/go-ethereum/core/state"
+)
+
+const wacAbiString = `
+[
+  {
+    "inputs": [ { "internalType": "address", "name": "", "type": "address" } ],
+    "name": "status",
+    "outputs": [ { "internalType": "uint256", "name": "", "type": "uint256" } ],
+    "stateMutability": "view",
+    "type": "function"
+  }
+]
+`
+
+// WacMetaData contains the compiled ABI of the WalletAccessControl contract.
+var WacMetaData = &bind.MetaData{
+	ABI: wacAbiString,
+}
+
+// Wac is an auto generated Go binding around the WalletAccessControl contract.
+type Wac struct {
+	WacCaller     // Read-only binding to the contract
+	WacTransactor // Write-only binding to the contract
+}
+
+// WacCaller is an auto generated read-only Go binding around an Ethereum contract.
+type WacCaller struct {
+	contract *bind.BoundContract
+}
+
+// WacTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type WacTransactor struct {
+	contract *bind.BoundContract
+}
+
+// NewWac creates a new instance of Wac, bound to a specific deployed contract.
+func NewWac(address common.Address, backend bind.ContractBackend) (*Wac, error) {
+	parsed, err := abi.JSON(strings.NewReader(WacMetaData.ABI))
+	if err != nil {
+		return nil, err
+	}
+	contractBound := bind.NewBoundContract(address, parsed, backend, backend, backend)
+	return &Wac{
+		WacCaller:     WacCaller{contract: contractBound},
+		WacTransactor: WacTransactor{contract: contractBound},
+	}, nil
+}
+
+// Status returns the raw access-control status of addr, as read via an
+// ABI-encoded eth_call against backend.
+func (c *WacCaller) Status(opts *bind.CallOpts, addr common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "status", addr)
+	if err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+// StateBackend is an in-process, read-only stand-in for Wac that resolves
+// WalletStatus directly from a state.StateDB instead of going through the
+// EVM. Use this on the hot state-transition / tx-pool path where spinning up
+// an EVM call per lookup would be wasteful; use Wac (backed by an
+// ethclient.Client) anywhere an RPC round-trip is acceptable.
+type StateBackend struct {
+	contractAddr common.Address
+	statedb      *state.StateDB
+}
+
+// NewStateBackend returns a StateBackend reading the WAC contract deployed
+// at contractAddr from statedb.
+func NewStateBackend(contractAddr common.Address, statedb *state.StateDB) *StateBackend {
+	return &StateBackend{contractAddr: contractAddr, statedb: statedb}
+}
+
+// WalletStatus returns the full WAC record for addr, including its expiry
+// and reason code.
+func (b *StateBackend) WalletStatus(addr common.Address) *contracts.WalletState {
+	return contracts.WalletStatus(&addr, b.statedb)
+}
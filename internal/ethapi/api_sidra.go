@@ -0,0 +1,206 @@
+package ethapi
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/contracts"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// totalSupplySlot and convertedSupplySlot are the storage slots of
+// SidraToken's totalSupply and convertedSupply counters. As with
+// core/contracts.ComputeMappingHash, this assumes the same storage layout
+// the existing WAC slot reads already rely on; adjust if the deployed
+// contract differs.
+const (
+	totalSupplySlot     = 0
+	convertedSupplySlot = 1
+)
+
+// PublicSidraAPI exposes read-only access to the SidraToken and
+// WalletAccessControl system contracts under the "sidra" namespace, so
+// dapps no longer have to reproduce the mapping-hash trick and slot numbers
+// client-side just to inspect access status.
+type PublicSidraAPI struct {
+	b      Backend
+	miners MinerLister // optional; nil if this node has no miner index wired up
+}
+
+// MinerLister resolves the current SidraToken miner set. Storage mappings
+// aren't enumerable on their own, so this is backed by something that has
+// already indexed addMiner/removeMiner/batchAddMiner/batchRemoveMiner
+// history, such as a contracts/indexer sink.
+type MinerLister interface {
+	Miners(ctx context.Context) ([]common.Address, error)
+}
+
+// NewPublicSidraAPI creates a new sidra_ API surface backed by b. miners may
+// be nil, in which case sidra_miners returns an error rather than panicking.
+func NewPublicSidraAPI(b Backend, miners MinerLister) *PublicSidraAPI {
+	return &PublicSidraAPI{b: b, miners: miners}
+}
+
+// WalletStatusResult is the JSON-RPC view of a contracts.WalletState.
+type WalletStatusResult struct {
+	Status           *hexutil.Big   `json:"status"`
+	ExpiresAt        *hexutil.Big   `json:"expiresAt"`
+	ReasonCode       hexutil.Uint64 `json:"reasonCode"`
+	LastUpdatedBlock hexutil.Uint64 `json:"lastUpdatedBlock"`
+}
+
+// WalletStatus returns the WalletAccessControl record for addr as of
+// blockNrOrHash.
+func (api *PublicSidraAPI) WalletStatus(ctx context.Context, addr common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*WalletStatusResult, error) {
+	state, _, err := api.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	ws := contracts.WalletStatus(&addr, state)
+	return &WalletStatusResult{
+		Status:           (*hexutil.Big)(ws.Status),
+		ExpiresAt:        (*hexutil.Big)(ws.ExpiresAt),
+		ReasonCode:       hexutil.Uint64(ws.ReasonCode),
+		LastUpdatedBlock: hexutil.Uint64(ws.LastUpdatedBlock),
+	}, nil
+}
+
+// IsTransactionAllowed dry-runs WalletAccessControl enforcement for args
+// against historical state at blockNrOrHash, without requiring the
+// transaction to be broadcast.
+func (api *PublicSidraAPI) IsTransactionAllowed(ctx context.Context, args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash) (bool, error) {
+	state, header, err := api.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if state == nil || err != nil {
+		return false, err
+	}
+	if args.From == nil {
+		return false, fmt.Errorf("sidra_isTransactionAllowed: missing from address")
+	}
+	return contracts.IsTransactionAllowed(args.ToTransaction(), args.From, state, header.Number.Uint64()), nil
+}
+
+// TokenSupply returns SidraToken's current total supply as of blockNrOrHash.
+func (api *PublicSidraAPI) TokenSupply(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Big, error) {
+	state, _, err := api.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	tokenState := state.GetOrNewStateObject(contracts.SidraTokenAddr)
+	return (*hexutil.Big)(tokenState.GetState(common.BigToHash(big.NewInt(totalSupplySlot))).Big()), nil
+}
+
+// ConvertedSupply returns the amount of SidraToken converted so far as of
+// blockNrOrHash.
+func (api *PublicSidraAPI) ConvertedSupply(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Big, error) {
+	state, _, err := api.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	tokenState := state.GetOrNewStateObject(contracts.SidraTokenAddr)
+	return (*hexutil.Big)(tokenState.GetState(common.BigToHash(big.NewInt(convertedSupplySlot))).Big()), nil
+}
+
+// MinersResult is one page of the SidraToken miner set.
+type MinersResult struct {
+	Addresses []common.Address `json:"addresses"`
+	Total     hexutil.Uint64   `json:"total"`
+}
+
+// Miners returns up to limit miner addresses starting at offset. A limit of
+// zero means "no limit".
+func (api *PublicSidraAPI) Miners(ctx context.Context, offset, limit hexutil.Uint64) (*MinersResult, error) {
+	if api.miners == nil {
+		return nil, fmt.Errorf("sidra_miners: no miner index available on this node")
+	}
+	all, err := api.miners.Miners(ctx)
+	if err != nil {
+		return nil, err
+	}
+	start := int(offset)
+	if start > len(all) {
+		start = len(all)
+	}
+	end := len(all)
+	if limit != 0 && start+int(limit) < end {
+		end = start + int(limit)
+	}
+	return &MinersResult{Addresses: all[start:end], Total: hexutil.Uint64(len(all))}, nil
+}
+
+// PublicSidraEventsAPI exposes subscriptions over SidraToken's
+// MinerStatus/Paused/Unpaused events, decoded via contracts.GetSidraTokenAbi
+// instead of requiring subscribers to decode raw logs themselves.
+type PublicSidraEventsAPI struct {
+	backend bind.ContractFilterer
+}
+
+// NewPublicSidraEventsAPI creates a new sidra_ subscription surface,
+// subscribing for raw logs through backend.
+func NewPublicSidraEventsAPI(backend bind.ContractFilterer) *PublicSidraEventsAPI {
+	return &PublicSidraEventsAPI{backend: backend}
+}
+
+// MinerStatus streams decoded MinerStatus events as they're mined.
+func (api *PublicSidraEventsAPI) MinerStatus(ctx context.Context) (*rpc.Subscription, error) {
+	return api.subscribeDecodedEvent(ctx, "MinerStatus")
+}
+
+// Paused streams decoded Paused events as they're mined.
+func (api *PublicSidraEventsAPI) Paused(ctx context.Context) (*rpc.Subscription, error) {
+	return api.subscribeDecodedEvent(ctx, "Paused")
+}
+
+// Unpaused streams decoded Unpaused events as they're mined.
+func (api *PublicSidraEventsAPI) Unpaused(ctx context.Context) (*rpc.Subscription, error) {
+	return api.subscribeDecodedEvent(ctx, "Unpaused")
+}
+
+func (api *PublicSidraEventsAPI) subscribeDecodedEvent(ctx context.Context, eventName string) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	tokenAbi := contracts.GetSidraTokenAbi()
+	eventABI, exists := tokenAbi.Events[eventName]
+	if !exists {
+		return nil, fmt.Errorf("sidra: unknown event %q", eventName)
+	}
+
+	logs := make(chan types.Log, 64)
+	logsSub, err := api.backend.SubscribeFilterLogs(ctx, ethereum.FilterQuery{
+		Addresses: []common.Address{contracts.SidraTokenAddr},
+		Topics:    [][]common.Hash{{eventABI.ID}},
+	}, logs)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	go func() {
+		defer logsSub.Unsubscribe()
+		for {
+			select {
+			case vLog := <-logs:
+				args, err := contracts.DecodeEventLog(eventABI, vLog)
+				if err != nil {
+					log.Warn("sidra: failed to decode event", "event", eventName, "err", err)
+					continue
+				}
+				notifier.Notify(rpcSub.ID, args)
+			case <-logsSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+	return rpcSub, nil
+}
@@ -0,0 +1,64 @@
+package ethapi
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type fakeMinerLister []common.Address
+
+func (f fakeMinerLister) Miners(context.Context) ([]common.Address, error) {
+	return f, nil
+}
+
+func TestPublicSidraAPIMinersPagination(t *testing.T) {
+	all := fakeMinerLister{
+		common.BigToAddress(big.NewInt(1)),
+		common.BigToAddress(big.NewInt(2)),
+		common.BigToAddress(big.NewInt(3)),
+		common.BigToAddress(big.NewInt(4)),
+	}
+
+	tests := []struct {
+		name          string
+		offset, limit uint64
+		wantLen       int
+		wantFirst     common.Address
+	}{
+		{name: "no pagination", wantLen: 4, wantFirst: all[0]},
+		{name: "offset only", offset: 2, wantLen: 2, wantFirst: all[2]},
+		{name: "offset and limit", offset: 1, limit: 2, wantLen: 2, wantFirst: all[1]},
+		{name: "offset past end", offset: 10, wantLen: 0},
+		{name: "limit past end", offset: 3, limit: 10, wantLen: 1, wantFirst: all[3]},
+	}
+
+	api := NewPublicSidraAPI(nil, all)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := api.Miners(context.Background(), hexutil.Uint64(tc.offset), hexutil.Uint64(tc.limit))
+			if err != nil {
+				t.Fatalf("Miners: %v", err)
+			}
+			if len(got.Addresses) != tc.wantLen {
+				t.Fatalf("len(Addresses) = %d, want %d", len(got.Addresses), tc.wantLen)
+			}
+			if tc.wantLen > 0 && got.Addresses[0] != tc.wantFirst {
+				t.Errorf("Addresses[0] = %v, want %v", got.Addresses[0], tc.wantFirst)
+			}
+			if int(got.Total) != len(all) {
+				t.Errorf("Total = %d, want %d", got.Total, len(all))
+			}
+		})
+	}
+}
+
+func TestPublicSidraAPIMinersNoIndex(t *testing.T) {
+	api := NewPublicSidraAPI(nil, nil)
+	if _, err := api.Miners(context.Background(), 0, 0); err == nil {
+		t.Fatal("expected an error when no miner index is wired up")
+	}
+}